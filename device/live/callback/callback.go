@@ -0,0 +1,221 @@
+//Copyright cbping
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License
+
+//
+//  阿里云直播推拉流事件回调（Webhook）服务端
+//  文档信息：https://help.aliyun.com/document_detail/34298.html
+//  @author cbping
+package callback
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxTimestampSkew 回调请求时间戳允许的最大偏移量，超过视为非法请求
+const maxTimestampSkew = 5 * time.Minute
+
+// PublishEvent 推流事件
+type PublishEvent struct {
+	AppName    string
+	StreamName string
+	Time       time.Time
+	IP         string
+	URL        string
+}
+
+// PublishDoneEvent 断流事件
+type PublishDoneEvent struct {
+	AppName    string
+	StreamName string
+	Time       time.Time
+	IP         string
+	URL        string
+	Duration   time.Duration
+}
+
+// RecordEvent 录制完成事件
+type RecordEvent struct {
+	AppName    string
+	StreamName string
+	Time       time.Time
+	OssBucket  string
+	OssObject  string
+}
+
+// SnapshotEvent 截图完成事件
+type SnapshotEvent struct {
+	AppName    string
+	StreamName string
+	Time       time.Time
+	OssBucket  string
+	OssObject  string
+}
+
+// PublishHandler 推流事件处理器，返回非nil错误将拒绝本次推流（HTTP非2xx应答）
+type PublishHandler func(ctx context.Context, evt PublishEvent) error
+
+// PublishDoneHandler 断流事件处理器
+type PublishDoneHandler func(ctx context.Context, evt PublishDoneEvent) error
+
+// RecordHandler 录制完成事件处理器
+type RecordHandler func(ctx context.Context, evt RecordEvent) error
+
+// SnapshotHandler 截图完成事件处理器
+type SnapshotHandler func(ctx context.Context, evt SnapshotEvent) error
+
+// Server 阿里云直播事件回调服务端，实现 http.Handler
+//      每一个实例对应一份共享密钥（secret），该密钥用于校验回调请求的签名
+// @author cbping
+type Server struct {
+	secret string
+
+	onPublish     PublishHandler
+	onPublishDone PublishDoneHandler
+	onRecord      RecordHandler
+	onSnapshot    SnapshotHandler
+}
+
+// NewServer 新建"直播事件回调服务端"
+//      secret 与阿里云控制台配置的回调URL共享的密钥，用于校验请求签名
+func NewServer(secret string) *Server {
+	return &Server{secret: secret}
+}
+
+// OnPublish 注册推流事件处理器
+func (s *Server) OnPublish(h PublishHandler) {
+	s.onPublish = h
+}
+
+// OnPublishDone 注册断流事件处理器
+func (s *Server) OnPublishDone(h PublishDoneHandler) {
+	s.onPublishDone = h
+}
+
+// OnRecord 注册录制完成事件处理器
+func (s *Server) OnRecord(h RecordHandler) {
+	s.onRecord = h
+}
+
+// OnSnapshot 注册截图完成事件处理器
+func (s *Server) OnSnapshot(h SnapshotHandler) {
+	s.onSnapshot = h
+}
+
+// ServeHTTP 实现 http.Handler，分发推流/断流/录制/截图事件
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); nil != err {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	if !s.verify(r) {
+		http.Error(w, "invalid sign", http.StatusForbidden)
+		return
+	}
+
+	ctx := r.Context()
+	form := r.Form
+
+	var err error
+	switch form.Get("action") {
+	case "on_publish":
+		if nil != s.onPublish {
+			err = s.onPublish(ctx, PublishEvent{
+				AppName:    form.Get("app"),
+				StreamName: form.Get("stream"),
+				Time:       parseUnix(form.Get("time")),
+				IP:         form.Get("ip"),
+				URL:        form.Get("url"),
+			})
+		}
+	case "on_publish_done":
+		if nil != s.onPublishDone {
+			err = s.onPublishDone(ctx, PublishDoneEvent{
+				AppName:    form.Get("app"),
+				StreamName: form.Get("stream"),
+				Time:       parseUnix(form.Get("time")),
+				IP:         form.Get("ip"),
+				URL:        form.Get("url"),
+				Duration:   time.Duration(parseInt(form.Get("duration"))) * time.Second,
+			})
+		}
+	case "on_record":
+		if nil != s.onRecord {
+			err = s.onRecord(ctx, RecordEvent{
+				AppName:    form.Get("app"),
+				StreamName: form.Get("stream"),
+				Time:       parseUnix(form.Get("time")),
+				OssBucket:  form.Get("oss_bucket"),
+				OssObject:  form.Get("oss_object"),
+			})
+		}
+	case "on_snapshot":
+		if nil != s.onSnapshot {
+			err = s.onSnapshot(ctx, SnapshotEvent{
+				AppName:    form.Get("app"),
+				StreamName: form.Get("stream"),
+				Time:       parseUnix(form.Get("time")),
+				OssBucket:  form.Get("oss_bucket"),
+				OssObject:  form.Get("oss_object"),
+			})
+		}
+	default:
+		http.Error(w, "unknown action", http.StatusBadRequest)
+		return
+	}
+
+	if nil != err {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify 校验回调请求的签名与时间戳，签名算法: sign = md5(path + "-" + t + "-" + secret)
+func (s *Server) verify(r *http.Request) bool {
+	if "" == s.secret {
+		return true
+	}
+
+	t := parseInt(r.Form.Get("t"))
+	if 0 == t {
+		return false
+	}
+	if skew := time.Since(time.Unix(t, 0)); skew > maxTimestampSkew || skew < -maxTimestampSkew {
+		return false
+	}
+
+	want := md5.Sum([]byte(fmt.Sprintf("%s-%d-%s", r.URL.Path, t, s.secret)))
+	got, err := hex.DecodeString(r.Form.Get("sign"))
+	if nil != err {
+		return false
+	}
+	return 1 == subtle.ConstantTimeCompare(want[:], got)
+}
+
+func parseUnix(s string) time.Time {
+	return time.Unix(parseInt(s), 0)
+}
+
+func parseInt(s string) int64 {
+	v, _ := strconv.ParseInt(s, 10, 64)
+	return v
+}
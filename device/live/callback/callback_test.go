@@ -0,0 +1,39 @@
+package callback
+
+import (
+	"crypto/md5"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestServerVerify(t *testing.T) {
+	s := NewServer("test-secret")
+
+	now := time.Now().Unix()
+	path := "/callback"
+	sign := fmt.Sprintf("%x", md5.Sum([]byte(fmt.Sprintf("%s-%d-%s", path, now, "test-secret"))))
+
+	form := url.Values{}
+	form.Set("t", fmt.Sprintf("%d", now))
+	form.Set("sign", sign)
+
+	r := httptest.NewRequest("POST", path+"?"+form.Encode(), nil)
+	if err := r.ParseForm(); nil != err {
+		t.Fatal(err)
+	}
+	if !s.verify(r) {
+		t.Error("verify() should succeed with a valid signature")
+	}
+
+	form.Set("sign", "wrong")
+	r = httptest.NewRequest("POST", path+"?"+form.Encode(), nil)
+	if err := r.ParseForm(); nil != err {
+		t.Fatal(err)
+	}
+	if s.verify(r) {
+		t.Error("verify() should fail with an invalid signature")
+	}
+}
@@ -0,0 +1,310 @@
+//Copyright cbping
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License
+
+//
+//  导播台（播单型导播台）管理接口
+//  文档信息：https://help.aliyun.com/document_detail/90323.html
+//  @author cbping
+package caster
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/BPing/aliyun-live-go-sdk/aliyun"
+	"github.com/BPing/aliyun-live-go-sdk/device/live"
+	"github.com/BPing/go-toolkit/http-client/core"
+)
+
+const (
+	CreateCasterAction              = "CreateCaster"
+	StartCasterAction               = "StartCaster"
+	StopCasterAction                = "StopCaster"
+	ModifyCasterAction              = "ModifyCaster"
+	DeleteCasterAction              = "DeleteCaster"
+	AddCasterComponentAction        = "AddCasterComponent"
+	AddCasterEpisodeAction          = "AddCasterEpisode"
+	AddCasterProgramResourceAction  = "AddCasterProgramResource"
+	SetCasterLayoutAction           = "SetCasterLayout"
+	SetCasterChannelAction          = "SetCasterChannel"
+	EffectCasterVideoResourceAction = "EffectCasterVideoResource"
+	DescribeCasterStreamUrlAction   = "DescribeCasterStreamUrl"
+)
+
+// ChargeType 计费类型
+type ChargeType string
+
+const (
+	// PostPaid 按量付费
+	PostPaid ChargeType = "PostPaid"
+	// PrePaid 包年包月
+	PrePaid ChargeType = "PrePaid"
+)
+
+// NormType 导播台规格
+type NormType string
+
+const (
+	// Norm1080P 1080P规格
+	Norm1080P NormType = "STANDARD_1080P"
+	// Norm720P 720P规格
+	Norm720P NormType = "STANDARD_720P"
+)
+
+// CreateCasterRequest 创建导播台的参数
+type CreateCasterRequest struct {
+	// CasterName 导播台名称
+	CasterName string
+
+	// ChargeType 计费类型
+	ChargeType ChargeType
+
+	// NormType 导播台规格
+	NormType NormType
+}
+
+// ComponentConfig 花字、图标等节目组件配置
+type ComponentConfig struct {
+	// Type 组件类型，例如"image"、"text"
+	Type string `json:"Type"`
+
+	// X、Y、Width、Height 组件在画面中的位置与尺寸，取值范围[0,1]（相对画面比例）
+	X      float64 `json:"X"`
+	Y      float64 `json:"Y"`
+	Width  float64 `json:"Width"`
+	Height float64 `json:"Height"`
+
+	// Content 组件内容，文字组件为文本，图片组件为图片地址
+	Content string `json:"Content,omitempty"`
+}
+
+// EpisodeConfig 节目单中单个节目的配置
+type EpisodeConfig struct {
+	// Name 节目名称
+	Name string `json:"Name"`
+
+	// StartTime 节目开始时间，ISO8601格式，空代表紧跟上一个节目
+	StartTime string `json:"StartTime,omitempty"`
+
+	// Duration 节目时长，单位秒，0代表不限制
+	Duration int `json:"Duration,omitempty"`
+}
+
+// ResourceConfig 节目源（直播流/点播文件）配置
+type ResourceConfig struct {
+	// Type 资源类型："live"或"vod"
+	Type string `json:"Type"`
+
+	// Url 资源地址
+	Url string `json:"Url"`
+}
+
+// Layout 导播台布局配置
+type Layout struct {
+	// LayoutId 布局模板编号
+	LayoutId int `json:"LayoutId"`
+
+	// Params 布局自定义参数，不同LayoutId含义不同
+	Params map[string]interface{} `json:"Params,omitempty"`
+}
+
+// ChannelConfig 导播台输出通道内容配置
+type ChannelConfig struct {
+	// ProgramEffect 当前生效的节目源Id
+	ProgramEffect string `json:"ProgramEffect,omitempty"`
+
+	// Outputs 该通道对应的推流地址列表
+	Outputs []string `json:"Outputs,omitempty"`
+}
+
+// Caster 导播台实例
+type Caster struct {
+	CasterId   string `json:"CasterId" xml:"CasterId"`
+	CasterName string `json:"CasterName" xml:"CasterName"`
+	Status     string `json:"Status" xml:"Status"`
+}
+
+// CasterClient 导播台接口控制器
+//      每一个实例都固定对应一个Cdn，并且无法更改
+// @author cbping
+type CasterClient struct {
+	rpc *aliyun.Client
+	req *live.Request
+}
+
+// NewCasterClient 新建"导播台接口控制器"
+//      cert 请求凭证
+//      domainName 加速域名
+func NewCasterClient(cert *aliyun.Credentials, domainName string) *CasterClient {
+	return &CasterClient{
+		rpc: aliyun.NewClientCtx(core.BackgroundContext(), cert),
+		req: live.NewLiveRequest("", domainName, ""),
+	}
+}
+
+func (c *CasterClient) cloneRequest(action string) *live.Request {
+	req := c.req.Clone().(*live.Request)
+	req.Action = action
+	req.SetArgs("ClientToken", newClientToken())
+	return req
+}
+
+// marshalArg 将类型化的请求参数编码为JSON字符串，以单个RPC参数的形式提交
+func marshalArg(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if nil != err {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Create 创建导播台
+func (c *CasterClient) Create(r CreateCasterRequest) (caster *Caster, err error) {
+	if "" == r.CasterName {
+		return nil, errors.New("CasterName should not to be empty")
+	}
+	req := c.cloneRequest(CreateCasterAction)
+	req.SetArgs("CasterName", r.CasterName)
+	req.SetArgs("ChargeType", string(r.ChargeType))
+	req.SetArgs("NormType", string(r.NormType))
+
+	caster = &Caster{}
+	err = c.rpc.Query(req, caster)
+	return
+}
+
+// Start 开启导播台
+func (c *CasterClient) Start(casterId string) (err error) {
+	req := c.cloneRequest(StartCasterAction)
+	req.SetArgs("CasterId", casterId)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// Stop 关闭导播台
+func (c *CasterClient) Stop(casterId string) (err error) {
+	req := c.cloneRequest(StopCasterAction)
+	req.SetArgs("CasterId", casterId)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// Modify 修改导播台属性
+func (c *CasterClient) Modify(casterId, casterName string) (err error) {
+	req := c.cloneRequest(ModifyCasterAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("CasterName", casterName)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// Delete 删除导播台
+func (c *CasterClient) Delete(casterId string) (err error) {
+	req := c.cloneRequest(DeleteCasterAction)
+	req.SetArgs("CasterId", casterId)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// AddComponent 添加花字、图标等节目组件
+func (c *CasterClient) AddComponent(casterId string, config ComponentConfig) (err error) {
+	componentConfig, err := marshalArg(config)
+	if nil != err {
+		return
+	}
+	req := c.cloneRequest(AddCasterComponentAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("ComponentConfig", componentConfig)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// AddEpisode 添加节目单中的一个节目
+func (c *CasterClient) AddEpisode(casterId string, config EpisodeConfig) (err error) {
+	episodeConfig, err := marshalArg(config)
+	if nil != err {
+		return
+	}
+	req := c.cloneRequest(AddCasterEpisodeAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("EpisodeConfig", episodeConfig)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// AddResource 添加节目源（直播流/点播文件）
+func (c *CasterClient) AddResource(casterId, episodeId string, config ResourceConfig) (err error) {
+	resourceConfig, err := marshalArg(config)
+	if nil != err {
+		return
+	}
+	req := c.cloneRequest(AddCasterProgramResourceAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("EpisodeId", episodeId)
+	req.SetArgs("ResourceConfig", resourceConfig)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// SetLayout 设置导播台布局
+func (c *CasterClient) SetLayout(casterId string, layout Layout) (err error) {
+	layoutArg, err := marshalArg(layout)
+	if nil != err {
+		return
+	}
+	req := c.cloneRequest(SetCasterLayoutAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("Layout", layoutArg)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// SetChannel 设置导播台通道内容
+func (c *CasterClient) SetChannel(casterId, channelId string, config ChannelConfig) (err error) {
+	channelConfig, err := marshalArg(config)
+	if nil != err {
+		return
+	}
+	req := c.cloneRequest(SetCasterChannelAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("ChannelId", channelId)
+	req.SetArgs("ChannelConfig", channelConfig)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// EffectCasterVideoResource 使节目源生效（切换播放）
+func (c *CasterClient) EffectCasterVideoResource(casterId, episodeId, resourceId string) (err error) {
+	req := c.cloneRequest(EffectCasterVideoResourceAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("EpisodeId", episodeId)
+	req.SetArgs("ResourceId", resourceId)
+	err = c.rpc.Query(req, nil)
+	return
+}
+
+// DescribeCasterStreamURL 查询导播台输出流地址
+func (c *CasterClient) DescribeCasterStreamURL(casterId, channelId string) (streamURL string, err error) {
+	req := c.cloneRequest(DescribeCasterStreamUrlAction)
+	req.SetArgs("CasterId", casterId)
+	req.SetArgs("ChannelId", channelId)
+
+	resp := struct {
+		StreamUrl string `json:"StreamUrl" xml:"StreamUrl"`
+	}{}
+	if err = c.rpc.Query(req, &resp); nil != err {
+		return
+	}
+	streamURL = resp.StreamUrl
+	return
+}
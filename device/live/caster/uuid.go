@@ -0,0 +1,17 @@
+package caster
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newClientToken 生成一个随机的ClientToken（UUID v4），用于请求幂等
+func newClientToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); nil != err {
+		return ""
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,179 @@
+//Copyright cbping
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License
+
+package live
+
+import (
+	"io"
+	"sync"
+
+	"github.com/BPing/go-toolkit/http-client/core"
+)
+
+// defaultIterPageSize 迭代器每页拉取的流数量
+const defaultIterPageSize = 100
+
+// batchDescribeChunkSize 阿里云单次查询允许携带的流名数量上限
+const batchDescribeChunkSize = 100
+
+// OnlineStream 在线流信息
+type OnlineStream struct {
+	AppName     string `json:"AppName" xml:"AppName"`
+	DomainName  string `json:"DomainName" xml:"DomainName"`
+	StreamName  string `json:"StreamName" xml:"StreamName"`
+	PublishTime string `json:"PublishTime" xml:"PublishTime"`
+	PublishUrl  string `json:"PublishUrl" xml:"PublishUrl"`
+}
+
+// StreamIterator 在线流分页迭代器，自动翻页直至遇到空页为止
+//      通过 Live.StreamsOnlineIter 创建
+type StreamIterator struct {
+	live *Live
+
+	pageNumber int
+	pageSize   int
+
+	buf  []OnlineStream
+	idx  int
+	done bool
+
+	// fetchPage 实际拉取一页数据的方法，默认通过live.rpc请求阿里云接口；
+	// 测试时可替换为桩实现，无需真正发起网络请求
+	fetchPage func(pageNumber, pageSize int) ([]OnlineStream, error)
+}
+
+// StreamsOnlineIter 创建在线流分页迭代器
+// @link https://help.aliyun.com/document_detail/27192.html?spm=0.0.0.0.7uWhjM
+func (l *Live) StreamsOnlineIter() *StreamIterator {
+	it := &StreamIterator{
+		live:       l,
+		pageNumber: 1,
+		pageSize:   defaultIterPageSize,
+	}
+	it.fetchPage = it.fetchPageViaRPC
+	return it
+}
+
+// Next 返回下一条在线流信息，没有更多数据时返回 io.EOF
+//      ctx 用于中断长时间运行的翻页遍历：每次需要拉取新的一页之前都会检查ctx是否已被取消，
+//      若已取消则立即返回ctx.Err()，不再发起新的请求（aliyun.Client尚未暴露单次请求级别的context，
+//      因此无法中断已经发出的那一次请求）
+func (it *StreamIterator) Next(ctx core.Context) (*OnlineStream, error) {
+	for it.idx >= len(it.buf) {
+		if it.done {
+			return nil, io.EOF
+		}
+		if nil != ctx {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
+		if err := it.fetchNextPage(); nil != err {
+			return nil, err
+		}
+	}
+
+	stream := &it.buf[it.idx]
+	it.idx++
+	return stream, nil
+}
+
+func (it *StreamIterator) fetchNextPage() (err error) {
+	page, err := it.fetchPage(it.pageNumber, it.pageSize)
+	if nil != err {
+		return
+	}
+
+	it.buf = page
+	it.idx = 0
+	it.pageNumber++
+	if len(page) < it.pageSize {
+		it.done = true
+	}
+	return
+}
+
+// fetchPageViaRPC 通过live.rpc查询一页在线流信息，是fetchPage的默认实现
+func (it *StreamIterator) fetchPageViaRPC(pageNumber, pageSize int) ([]OnlineStream, error) {
+	req := it.live.cloneRequest(DescribeLiveStreamsOnlineListAction)
+	req.SetArgs("PageNumber", pageNumber)
+	req.SetArgs("PageSize", pageSize)
+
+	resp := struct {
+		OnlineInfo struct {
+			LiveStreamOnlineInfo []OnlineStream `json:"LiveStreamOnlineInfo" xml:"LiveStreamOnlineInfo"`
+		} `json:"OnlineInfo" xml:"OnlineInfo"`
+	}{}
+	if err := it.live.rpc.Query(req, &resp); nil != err {
+		return nil, err
+	}
+	return resp.OnlineInfo.LiveStreamOnlineInfo, nil
+}
+
+// BatchDescribeLiveStreams 批量查询指定流的在线信息
+//      DescribeLiveStreamsOnlineList 按单个流名过滤，不支持一次请求携带多个流名，
+//      此方法将names以 batchDescribeChunkSize 为一批切分，同一批内的请求并发发起（真正意义上的"批量"，
+//      而不是串行地逐个请求），批与批之间顺序执行，从而把同时在途的请求数量限制在 batchDescribeChunkSize 以内
+func (l *Live) BatchDescribeLiveStreams(app string, names []string) (streams []OnlineStream, err error) {
+	return batchDescribe(names, batchDescribeChunkSize, func(name string) ([]OnlineStream, error) {
+		req := l.cloneRequestSafe(DescribeLiveStreamsOnlineListAction)
+		req.AppName = app
+		req.SetArgs("StreamName", name)
+
+		resp := struct {
+			OnlineInfo struct {
+				LiveStreamOnlineInfo []OnlineStream `json:"LiveStreamOnlineInfo" xml:"LiveStreamOnlineInfo"`
+			} `json:"OnlineInfo" xml:"OnlineInfo"`
+		}{}
+		if err := l.rpc.Query(req, &resp); nil != err {
+			return nil, err
+		}
+		return resp.OnlineInfo.LiveStreamOnlineInfo, nil
+	})
+}
+
+// batchDescribe 将names以chunkSize为一批切分，同一批内并发调用fetch并合并结果；
+// 抽取为独立函数以便在不依赖真实aliyun.Client的情况下测试分批、并发与合并逻辑
+func batchDescribe(names []string, chunkSize int, fetch func(name string) ([]OnlineStream, error)) (streams []OnlineStream, err error) {
+	for start := 0; start < len(names); start += chunkSize {
+		end := start + chunkSize
+		if end > len(names) {
+			end = len(names)
+		}
+
+		chunk := names[start:end]
+		chunkStreams, chunkErrs := make([][]OnlineStream, len(chunk)), make([]error, len(chunk))
+
+		var wg sync.WaitGroup
+		wg.Add(len(chunk))
+		for i, name := range chunk {
+			go func(i int, name string) {
+				defer wg.Done()
+				chunkStreams[i], chunkErrs[i] = fetch(name)
+			}(i, name)
+		}
+		wg.Wait()
+
+		for i := range chunk {
+			if nil != chunkErrs[i] {
+				err = chunkErrs[i]
+				return
+			}
+			streams = append(streams, chunkStreams[i]...)
+		}
+	}
+	return
+}
@@ -0,0 +1,120 @@
+package live
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+)
+
+// testCtx 适配 core.Context 接口所需的最小实现，底层委托给标准库 context.Context
+type testCtx struct {
+	context.Context
+}
+
+func TestStreamIteratorNextEmpty(t *testing.T) {
+	it := &StreamIterator{done: true}
+	if _, err := it.Next(nil); nil == err {
+		t.Error("Next() on an exhausted iterator should return an error")
+	}
+}
+
+func TestStreamIteratorNextPagination(t *testing.T) {
+	pages := [][]OnlineStream{
+		{{StreamName: "s1"}, {StreamName: "s2"}},
+		{{StreamName: "s3"}},
+		{},
+	}
+	calls := 0
+
+	it := &StreamIterator{pageNumber: 1, pageSize: 2}
+	it.fetchPage = func(pageNumber, pageSize int) ([]OnlineStream, error) {
+		defer func() { calls++ }()
+		if calls >= len(pages) {
+			return nil, nil
+		}
+		return pages[calls], nil
+	}
+
+	var got []string
+	for {
+		s, err := it.Next(nil)
+		if err == io.EOF {
+			break
+		}
+		if nil != err {
+			t.Fatalf("Next() unexpected error: %v", err)
+		}
+		got = append(got, s.StreamName)
+	}
+
+	want := []string{"s1", "s2", "s3"}
+	if len(got) != len(want) {
+		t.Fatalf("Next() collected %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Next()[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestStreamIteratorNextCtxCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fetchCalled := false
+	it := &StreamIterator{pageNumber: 1, pageSize: 1}
+	it.fetchPage = func(pageNumber, pageSize int) ([]OnlineStream, error) {
+		fetchCalled = true
+		return []OnlineStream{{StreamName: "s1"}}, nil
+	}
+
+	if _, err := it.Next(testCtx{ctx}); nil == err {
+		t.Error("Next() with a cancelled ctx should return an error")
+	}
+	if fetchCalled {
+		t.Error("Next() with a cancelled ctx should not fetch a new page")
+	}
+}
+
+func TestBatchDescribeMergesAcrossChunks(t *testing.T) {
+	names := []string{"s1", "s2", "s3", "s4", "s5"}
+
+	var mu sync.Mutex
+	seen := map[string]int{}
+
+	streams, err := batchDescribe(names, 2, func(name string) ([]OnlineStream, error) {
+		mu.Lock()
+		seen[name]++
+		mu.Unlock()
+		return []OnlineStream{{StreamName: name}}, nil
+	})
+	if nil != err {
+		t.Fatalf("batchDescribe() unexpected error: %v", err)
+	}
+	if len(streams) != len(names) {
+		t.Fatalf("batchDescribe() returned %d streams, want %d", len(streams), len(names))
+	}
+	for _, name := range names {
+		if 1 != seen[name] {
+			t.Errorf("batchDescribe() fetched %s %d times, want 1", name, seen[name])
+		}
+	}
+}
+
+func TestBatchDescribePropagatesError(t *testing.T) {
+	names := []string{"s1", "s2", "s3"}
+	wantErr := errors.New("boom")
+
+	_, err := batchDescribe(names, 2, func(name string) ([]OnlineStream, error) {
+		if "s2" == name {
+			return nil, wantErr
+		}
+		return []OnlineStream{{StreamName: name}}, nil
+	})
+	if err != wantErr {
+		t.Errorf("batchDescribe() error = %v, want %v", err, wantErr)
+	}
+}
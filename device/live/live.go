@@ -46,6 +46,14 @@ type Live struct {
 	// 例如您的域名是live.yourcompany.com，可以设置DNS，将您的域名CNAME指向video-center.alivecdn.com即可；
 	// 直播中心服务器或者自定义域名
 	videoCenterDns string
+
+	// 拉流（播放）域名，用于生成HLS/FLV/RTMP播放地址
+	// 如果为空，代表未开启拉流，Stream.PlayURL 系列方法不可用
+	pullDomain string
+
+	// 拉流防盗链鉴权Key（"A"类型鉴权，即时间戳防盗链）
+	// 如果为空，代表拉流地址不开启鉴权
+	pullAuthKey string
 }
 
 // 新建"直播接口控制器"
@@ -86,6 +94,8 @@ func (l *Live) GetStream(streamName string) *Stream {
 		videoCenterDns: l.videoCenterDns,
 		streamCert:     credentials,
 		signOn:         nil != l.streamCert,
+		pullDomain:     l.pullDomain,
+		pullAuthKey:    l.pullAuthKey,
 		live:           l,
 	}
 }
@@ -95,6 +105,15 @@ func (l *Live) cloneRequest(action string) (req *Request) {
 	return
 }
 
+// cloneRequestSafe 与cloneRequest功能相同，但不会修改l.liveReq，可安全地在多个goroutine中并发调用
+//      cloneRequest通过SetAction先修改l.liveReq.Action再克隆，对并发调用者而言是对共享状态的无同步写入；
+//      此方法先克隆，再仅修改克隆出来的副本，因此不会产生数据竞争
+func (l *Live) cloneRequestSafe(action string) (req *Request) {
+	req = l.liveReq.Clone().(*Request)
+	req.Action = action
+	return
+}
+
 // StreamsPublishList 获取推流列表
 // @appname 应用名 为空时，忽略此参数
 // @startTime 开始时间
@@ -225,3 +244,12 @@ func (l *Live) SetVideoCenter(videoCenterDns string) *Live {
 	l.videoCenterDns = videoCenterDns
 	return l
 }
+
+// SetPullDomain 设置拉流（播放）域名及对应的"A"类型（时间戳）防盗链鉴权Key
+//      domain 拉流加速域名，例如 pull.yourcompany.com
+//      pullAuthKey 拉流鉴权Key，为空代表拉流地址不开启鉴权
+func (l *Live) SetPullDomain(domain, pullAuthKey string) *Live {
+	l.pullDomain = domain
+	l.pullAuthKey = pullAuthKey
+	return l
+}
@@ -0,0 +1,103 @@
+//Copyright cbping
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License
+
+package live
+
+import (
+	"crypto/md5"
+	"fmt"
+	"time"
+)
+
+// PlayProtocol 拉流（播放）协议
+type PlayProtocol int
+
+const (
+	// RTMP 拉流协议
+	RTMP PlayProtocol = iota
+	// FLV (HTTP-FLV) 拉流协议
+	FLV
+	// HLS (M3U8) 拉流协议
+	HLS
+)
+
+// scheme 协议对应的URL scheme
+func (p PlayProtocol) scheme() string {
+	if RTMP == p {
+		return "rtmp"
+	}
+	return "http"
+}
+
+// ext 协议对应的文件后缀，RTMP协议无需后缀
+func (p PlayProtocol) ext() string {
+	switch p {
+	case FLV:
+		return ".flv"
+	case HLS:
+		return ".m3u8"
+	default:
+		return ""
+	}
+}
+
+// PlayURLs 一次性生成的播放地址三元组：RTMP、FLV、HLS
+type PlayURLs struct {
+	RTMP string
+	FLV  string
+	HLS  string
+}
+
+// PlayURL 生成拉流（播放）地址，带"A"类型（时间戳）防盗链鉴权
+//      protocol 拉流协议：RTMP、FLV或HLS
+//      ttl      鉴权过期时长，从当前时间开始计算；如果拉流域名未设置鉴权Key，则不附加auth_key参数
+//      如果未通过 Live.SetPullDomain 开启拉流，返回空字符串
+// @link https://help.aliyun.com/document_detail/85135.html
+func (s *Stream) PlayURL(protocol PlayProtocol, ttl time.Duration) string {
+	if "" == s.pullDomain {
+		return ""
+	}
+
+	uri := fmt.Sprintf("/%s/%s%s", s.appName, s.StreamName, protocol.ext())
+	url := fmt.Sprintf("%s://%s%s", protocol.scheme(), s.pullDomain, uri)
+
+	if "" == s.pullAuthKey {
+		return url
+	}
+
+	expireTs := time.Now().Add(ttl).Unix()
+	return fmt.Sprintf("%s?auth_key=%s", url, authKeyA(uri, expireTs, s.pullAuthKey))
+}
+
+// authKeyA 计算阿里云"A"类型（时间戳）防盗链的auth_key参数值
+//      sstring = "{uri}-{expireTs}-0-0-{pullAuthKey}"，auth_key = "{expireTs}-0-0-{md5(sstring)}"
+// @link https://help.aliyun.com/document_detail/85135.html
+func authKeyA(uri string, expireTs int64, pullAuthKey string) string {
+	sstring := fmt.Sprintf("%s-%d-0-0-%s", uri, expireTs, pullAuthKey)
+	md5sum := md5.Sum([]byte(sstring))
+	return fmt.Sprintf("%d-0-0-%x", expireTs, md5sum)
+}
+
+// PlayURLs 一次性生成RTMP、FLV、HLS三种协议的播放地址
+//      如果未通过 Live.SetPullDomain 开启拉流，返回nil
+func (s *Stream) PlayURLs(ttl time.Duration) *PlayURLs {
+	if "" == s.pullDomain {
+		return nil
+	}
+	return &PlayURLs{
+		RTMP: s.PlayURL(RTMP, ttl),
+		FLV:  s.PlayURL(FLV, ttl),
+		HLS:  s.PlayURL(HLS, ttl),
+	}
+}
@@ -0,0 +1,44 @@
+package live
+
+import "testing"
+
+// TestAuthKeyA 参考阿里云"A"类型（时间戳）防盗链签名示例
+// uri: /video/sn.m3u8  expireTs: 1444435200  key: private_key_for_test
+// @link https://help.aliyun.com/document_detail/85135.html
+func TestAuthKeyA(t *testing.T) {
+	want := "1444435200-0-0-17d1bf74dd8953ce4919e4681058a22a"
+	got := authKeyA("/video/sn.m3u8", 1444435200, "private_key_for_test")
+	if got != want {
+		t.Errorf("authKeyA() = %s, want %s", got, want)
+	}
+}
+
+func TestPlayURL(t *testing.T) {
+	s := &Stream{
+		appName:     "live",
+		StreamName:  "stream1",
+		pullDomain:  "pull.example.com",
+		pullAuthKey: "",
+	}
+
+	if got, want := s.PlayURL(HLS, 0), "http://pull.example.com/live/stream1.m3u8"; got != want {
+		t.Errorf("PlayURL(HLS) = %s, want %s", got, want)
+	}
+	if got, want := s.PlayURL(FLV, 0), "http://pull.example.com/live/stream1.flv"; got != want {
+		t.Errorf("PlayURL(FLV) = %s, want %s", got, want)
+	}
+	if got, want := s.PlayURL(RTMP, 0), "rtmp://pull.example.com/live/stream1"; got != want {
+		t.Errorf("PlayURL(RTMP) = %s, want %s", got, want)
+	}
+}
+
+func TestPlayURLNoPullDomain(t *testing.T) {
+	s := &Stream{appName: "live", StreamName: "stream1"}
+
+	if got := s.PlayURL(HLS, 0); "" != got {
+		t.Errorf("PlayURL() without a pull domain = %s, want empty string", got)
+	}
+	if got := s.PlayURLs(0); nil != got {
+		t.Errorf("PlayURLs() without a pull domain = %v, want nil", got)
+	}
+}
@@ -0,0 +1,103 @@
+//Copyright cbping
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License
+
+package live
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/BPing/aliyun-live-go-sdk/device/live/record"
+	"github.com/BPing/aliyun-live-go-sdk/util"
+	"time"
+)
+
+const (
+	AddLiveRecordConfigAction          = "AddLiveRecordConfig"
+	DeleteLiveAppRecordConfigAction    = "DeleteLiveAppRecordConfig"
+	DescribeLiveRecordConfigAction     = "DescribeLiveRecordConfig"
+	SubmitLiveStreamTranscodeJobAction = "SubmitLiveStreamTranscodeJob" // 片段录制转存点播（VOD）依赖的录制裁剪动作
+)
+
+// recordFormatArg 录制格式配置的线上报文结构，CycleDuration以秒为单位提交
+type recordFormatArg struct {
+	Format        record.Format `json:"Format"`
+	OssBucket     string        `json:"OssBucket"`
+	OssEndpoint   string        `json:"OssEndpoint"`
+	OssObject     string        `json:"OssObject"`
+	CycleDuration int           `json:"CycleDuration,omitempty"`
+}
+
+// AddRecordConfig 为应用（或指定流）开启OSS录制，持久化推流内容以供点播
+//      appName、streamName streamName为空代表对整个应用生效
+//      configs 每种录制格式各自的配置（OssBucket/OssEndpoint/OssObject/CycleDuration均可按格式单独指定），至少需要一个
+// @link https://help.aliyun.com/document_detail/85917.html
+func (l *Live) AddRecordConfig(appName, streamName string, configs ...record.Config) (err error) {
+	if 0 == len(configs) {
+		return errors.New("configs should not be empty")
+	}
+
+	args := make([]recordFormatArg, len(configs))
+	for i, c := range configs {
+		args[i] = recordFormatArg{
+			Format:        c.Format,
+			OssBucket:     c.OssBucket,
+			OssEndpoint:   c.OssEndpoint,
+			OssObject:     c.OssObject,
+			CycleDuration: int(c.CycleDuration / time.Second),
+		}
+	}
+	recordFormat, err := json.Marshal(args)
+	if nil != err {
+		return
+	}
+
+	req := l.cloneRequest(AddLiveRecordConfigAction)
+	req.AppName = appName
+	req.SetArgs("StreamName", streamName)
+	req.SetArgs("RecordFormat", string(recordFormat))
+	err = l.rpc.Query(req, nil)
+	return
+}
+
+// DeleteRecordConfig 关闭应用（或指定流）的OSS录制
+func (l *Live) DeleteRecordConfig(appName, streamName string) (err error) {
+	req := l.cloneRequest(DeleteLiveAppRecordConfigAction)
+	req.AppName = appName
+	req.SetArgs("StreamName", streamName)
+	err = l.rpc.Query(req, nil)
+	return
+}
+
+// DescribeRecordConfig 查询应用（或指定流）的OSS录制配置
+func (l *Live) DescribeRecordConfig(appName, streamName string, resp interface{}) (err error) {
+	req := l.cloneRequest(DescribeLiveRecordConfigAction)
+	req.AppName = appName
+	req.SetArgs("StreamName", streamName)
+	err = l.rpc.Query(req, resp)
+	return
+}
+
+// SaveAsVOD 将指定时间段的推流内容裁剪保存为点播（VOD）文件
+//      startTime、endTime 裁剪的起止时间
+//      outFormat 输出格式
+func (s *Stream) SaveAsVOD(startTime, endTime time.Time, outFormat record.Format) (err error) {
+	req := s.live.cloneRequest(SubmitLiveStreamTranscodeJobAction)
+	req.AppName = s.appName
+	req.SetArgs("StreamName", s.StreamName)
+	req.SetArgs("StartTime", util.GetISO8601TimeStamp(startTime))
+	req.SetArgs("EndTime", util.GetISO8601TimeStamp(endTime))
+	req.SetArgs("OutFormat", string(outFormat))
+	err = s.live.rpc.Query(req, nil)
+	return
+}
@@ -0,0 +1,60 @@
+//Copyright cbping
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License
+
+//
+//  直播录制（OSS LiveChannel录制）相关类型
+//  文档信息：https://help.aliyun.com/document_detail/85917.html
+//  @author cbping
+package record
+
+import "time"
+
+// Format 录制格式
+type Format string
+
+const (
+	// M3U8 HLS切片录制
+	M3U8 Format = "m3u8"
+	// MP4 MP4录制
+	MP4 Format = "mp4"
+	// FLV FLV录制
+	FLV Format = "flv"
+)
+
+// OssObject 模板变量
+// 用于 Config.OssObject，由阿里云在生成录制文件时替换为实际值
+const (
+	AppNameVar              = "{AppName}"
+	StreamNameVar           = "{StreamName}"
+	UnixTimestampVar        = "{UnixTimestamp}"
+	EscapedUnixTimestampVar = "{EscapedUnixTimestamp}"
+)
+
+// Config 单个格式的录制配置
+type Config struct {
+	// Format 录制格式：M3U8、MP4或FLV
+	Format Format
+
+	// OssBucket 录制文件存放的OSS Bucket
+	OssBucket string
+
+	// OssEndpoint OSS Bucket所在的Endpoint
+	OssEndpoint string
+
+	// OssObject 录制文件命名模板，支持 AppNameVar、StreamNameVar、UnixTimestampVar、EscapedUnixTimestampVar 占位符
+	OssObject string
+
+	// CycleDuration 切片录制周期，M3U8格式下生效，0代表使用默认值
+	CycleDuration time.Duration
+}
@@ -0,0 +1,92 @@
+//Copyright cbping
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License
+
+package live
+
+import (
+	"github.com/BPing/aliyun-live-go-sdk/util"
+	"time"
+)
+
+const (
+	AddLiveAppSnapshotConfigAction       = "AddLiveAppSnapshotConfig"
+	DeleteLiveAppSnapshotConfigAction    = "DeleteLiveAppSnapshotConfig"
+	DescribeLiveStreamSnapshotInfoAction = "DescribeLiveStreamSnapshotInfo"
+)
+
+// SnapshotConfig 封面（截图）配置
+type SnapshotConfig struct {
+	// OssBucket 截图存放的OSS Bucket
+	OssBucket string
+
+	// OssEndpoint OssBucket所在的Endpoint
+	OssEndpoint string
+
+	// Interval 截图间隔，单位秒
+	Interval int
+
+	// OverwriteOssObject 固定命名的截图对象，每次截图覆盖写入该对象，用于获取"最新一帧"封面
+	OverwriteOssObject string
+
+	// SequenceOssObject 序列命名的截图对象模板，每次截图生成新对象，用于保留历史截图序列
+	SequenceOssObject string
+}
+
+// SnapshotInfo 截图记录
+type SnapshotInfo struct {
+	OssBucket  string    `json:"OssBucket" xml:"OssBucket"`
+	OssObject  string    `json:"OssObject" xml:"OssObject"`
+	CreateTime time.Time `json:"CreateTime" xml:"CreateTime"`
+}
+
+// AddLiveAppSnapshotConfig 为应用开启截图（封面）功能
+func (l *Live) AddLiveAppSnapshotConfig(app string, conf *SnapshotConfig) (err error) {
+	req := l.cloneRequest(AddLiveAppSnapshotConfigAction)
+	req.AppName = app
+	req.SetArgs("OssBucket", conf.OssBucket)
+	req.SetArgs("OssEndpoint", conf.OssEndpoint)
+	req.SetArgs("Interval", conf.Interval)
+	req.SetArgs("OverwriteOssObject", conf.OverwriteOssObject)
+	req.SetArgs("SequenceOssObject", conf.SequenceOssObject)
+	err = l.rpc.Query(req, nil)
+	return
+}
+
+// DeleteLiveAppSnapshotConfig 关闭应用的截图（封面）功能
+func (l *Live) DeleteLiveAppSnapshotConfig(app string) (err error) {
+	req := l.cloneRequest(DeleteLiveAppSnapshotConfigAction)
+	req.AppName = app
+	err = l.rpc.Query(req, nil)
+	return
+}
+
+// DescribeLiveStreamSnapshotInfo 查询指定流在时间段内的截图记录
+func (l *Live) DescribeLiveStreamSnapshotInfo(appName, streamName string, startTime, endTime time.Time) (infos []SnapshotInfo, err error) {
+	req := l.cloneRequest(DescribeLiveStreamSnapshotInfoAction)
+	req.AppName = appName
+	req.SetArgs("StreamName", streamName)
+	req.SetArgs("StartTime", util.GetISO8601TimeStamp(startTime))
+	req.SetArgs("EndTime", util.GetISO8601TimeStamp(endTime))
+
+	resp := struct {
+		SnapshotInfoList struct {
+			SnapshotInfo []SnapshotInfo `json:"SnapshotInfo" xml:"SnapshotInfo"`
+		} `json:"SnapshotInfoList" xml:"SnapshotInfoList"`
+	}{}
+	if err = l.rpc.Query(req, &resp); nil != err {
+		return
+	}
+	infos = resp.SnapshotInfoList.SnapshotInfo
+	return
+}